@@ -0,0 +1,28 @@
+//go:build perf
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPackPerformanceBudgets fails when packing a synthetic tree takes longer
+// than its budget. Wall-clock timing depends on the machine and its load, so
+// it is only built with -tags perf, for checking a pipeline change locally.
+func TestPackPerformanceBudgets(t *testing.T) {
+	for _, shape := range syntheticShapes {
+		t.Run(shape.name, func(t *testing.T) {
+			root := writeSyntheticTree(t, shape.name)
+			result := testing.Benchmark(func(b *testing.B) { benchmarkPack(b, root) })
+			if result.N == 0 {
+				t.Fatal("the benchmark did not run; see the pack failure above")
+			}
+			took := time.Duration(result.NsPerOp())
+			t.Logf("%s: %v per pack (budget %v)", shape.name, took, shape.budget)
+			if took > shape.budget {
+				t.Errorf("packing the %s tree took %v, over its %v budget", shape.name, took, shape.budget)
+			}
+		})
+	}
+}
@@ -1,3 +1,8 @@
 module github.com/immazoni/PromptPacker
 
 go 1.24.0
+
+require (
+	github.com/klauspost/compress v1.18.0
+	golang.org/x/text v0.32.0
+)